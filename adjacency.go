@@ -0,0 +1,50 @@
+package graph
+
+// AdjacencyList returns a snapshot of outgoing adjacencies for every
+// vertex, including vertices with no outgoing edges. This is useful for
+// serialization, debugging, and interop with code that only cares about
+// TValue and not *Vertex[TValue] identity.
+func (g *Graph[TValue]) AdjacencyList() map[TValue][]TValue {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	adj := make(map[TValue][]TValue, len(g.vertices))
+	for _, v := range g.vertices {
+		adj[v.value] = nil
+	}
+
+	for _, v := range g.vertices {
+		for _, e := range g.edges[*v] {
+			adj[v.value] = append(adj[v.value], e.destination.value)
+		}
+	}
+
+	return adj
+}
+
+// TaggedAdjacency pairs a destination value with the tag of the edge
+// leading to it, as returned by TaggedAdjacencyList.
+type TaggedAdjacency[TValue comparable] struct {
+	To  TValue
+	Tag *string
+}
+
+// TaggedAdjacencyList is the AdjacencyList counterpart that also retains
+// each edge's tag, for callers that distinguish parallel edges by tag.
+func (g *Graph[TValue]) TaggedAdjacencyList() map[TValue][]TaggedAdjacency[TValue] {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	adj := make(map[TValue][]TaggedAdjacency[TValue], len(g.vertices))
+	for _, v := range g.vertices {
+		adj[v.value] = nil
+	}
+
+	for _, v := range g.vertices {
+		for _, e := range g.edges[*v] {
+			adj[v.value] = append(adj[v.value], TaggedAdjacency[TValue]{e.destination.value, e.tag})
+		}
+	}
+
+	return adj
+}
@@ -0,0 +1,43 @@
+package graph
+
+import "testing"
+
+func TestGraph_AdjacencyList(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(a, c, 1, nil)
+
+	adj := g.AdjacencyList()
+
+	if len(adj["A"]) != 2 {
+		t.Errorf("expected A to have 2 adjacencies, got %v", adj["A"])
+	}
+
+	if _, ok := adj["C"]; !ok {
+		t.Error("expected C to be present in the adjacency list even with no outgoing edges")
+	}
+}
+
+func TestGraph_TaggedAdjacencyList(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+
+	tag := "road"
+	_ = g.AddEdge(a, b, 1, &tag)
+
+	adj := g.TaggedAdjacencyList()
+
+	if len(adj["A"]) != 1 || adj["A"][0].To != "B" || *adj["A"][0].Tag != "road" {
+		t.Errorf("expected A -> B tagged 'road', got %v", adj["A"])
+	}
+}
@@ -0,0 +1,211 @@
+package graph
+
+import (
+	"container/heap"
+	"math"
+)
+
+// Edge pairs a destination vertex with the weight/tag of the edge leading
+// to it, as returned by WeightedGraph.EdgesFrom.
+type Edge[TValue comparable] struct {
+	To     *Vertex[TValue]
+	Weight uint
+	Tag    *string
+}
+
+// WeightedGraph is the minimal surface that path-finding algorithms need:
+// enumerate vertices, list a vertex's outgoing edges, and look up a
+// specific edge's weight. Graph[TValue] satisfies this directly, so
+// ShortestPath and AStar share one implementation regardless of which
+// concrete graph representation backs them.
+type WeightedGraph[TValue comparable] interface {
+	Vertices() []*Vertex[TValue]
+	EdgesFrom(v *Vertex[TValue]) []Edge[TValue]
+	Weight(src, dest *Vertex[TValue], tag *string) (uint, bool)
+}
+
+// Heuristic estimates the remaining cost from n to goal. For AStar to
+// guarantee a shortest path, h must be admissible: it must never
+// overestimate the true remaining cost. Unlike many textbook A*
+// implementations, search does not require h to also be consistent
+// (monotone) — it reopens any vertex whose distance improves after it has
+// already been expanded, so an admissible-but-inconsistent heuristic still
+// yields a correct shortest path, just with less of A*'s usual early-exit
+// speedup.
+type Heuristic[TValue comparable] func(n, goal *Vertex[TValue]) uint
+
+// NullHeuristic always returns 0, which makes AStar behave exactly like
+// Dijkstra's algorithm.
+func NullHeuristic[TValue comparable](n, goal *Vertex[TValue]) uint {
+	return 0
+}
+
+// Weighting overrides the cost of traversing an edge, independent of the
+// weight stored on it. It returns false for edges that don't exist.
+type Weighting[TValue comparable] func(src, dest *Vertex[TValue], tag *string) (uint, bool)
+
+// AStar finds a shortest path from src to dest using the A* algorithm,
+// guided by h. A nil h falls back to NullHeuristic, which makes this
+// equivalent to Dijkstra's algorithm. h only needs to be admissible (see
+// Heuristic); search reopens vertices as needed to stay correct even if h
+// isn't consistent, at the cost of some of A*'s usual early-exit speedup.
+func AStar[TValue comparable](g WeightedGraph[TValue], src, dest *Vertex[TValue], h Heuristic[TValue]) ([]PathEdge[TValue], error) {
+	if h == nil {
+		h = NullHeuristic[TValue]
+	}
+
+	return search[TValue](g, src, dest, h, nil)
+}
+
+// UniformCost finds a shortest path from src to dest treating every edge as
+// cost 1, regardless of its stored weight. This gives an unweighted
+// shortest path (i.e. fewest hops) over a weighted graph representation.
+func UniformCost[TValue comparable](g WeightedGraph[TValue], src, dest *Vertex[TValue]) ([]PathEdge[TValue], error) {
+	uniform := func(_, _ *Vertex[TValue], _ *string) (uint, bool) {
+		return 1, true
+	}
+
+	return search[TValue](g, src, dest, NullHeuristic[TValue], uniform)
+}
+
+type queueItem[TValue comparable] struct {
+	source *Vertex[TValue]
+	tag    *string
+	weight uint
+}
+
+// search is the shared Dijkstra/A* core: Dijkstra's algorithm with
+// priorities keyed on g[n] + h(n, goal), tracking the true cost (g[n])
+// separately in distance. h == NullHeuristic makes it plain Dijkstra.
+//
+// It does not stop as soon as dest is first popped: with a merely
+// admissible (not necessarily consistent) h, that first pop is not
+// guaranteed to carry dest's true shortest distance. Instead, inHeap
+// tracks whether a vertex currently has an entry in the queue; a relax
+// that improves a vertex already popped (inHeap == false) reopens it by
+// pushing it back on, and the search only ends once the queue drains.
+func search[TValue comparable](g WeightedGraph[TValue], src, dest *Vertex[TValue], h Heuristic[TValue], weighting Weighting[TValue]) ([]PathEdge[TValue], error) {
+	vertices := g.Vertices()
+
+	// g[v]: the best known true cost from src to v.
+	distance := make(map[*Vertex[TValue]]uint)
+	distance[src] = 0
+
+	inHeap := make(map[*Vertex[TValue]]bool, len(vertices))
+
+	q := &vertexDistanceHeap[TValue]{}
+	for _, v := range vertices {
+		if v != src {
+			distance[v] = math.MaxInt
+		}
+
+		heap.Push(q, vertexDistance[TValue]{vertex: v, distance: addSaturating(distance[v], h(v, dest))})
+		inHeap[v] = true
+	}
+
+	prev := make(map[*Vertex[TValue]]queueItem[TValue])
+
+	for q.Len() != 0 {
+		u := heap.Pop(q).(vertexDistance[TValue])
+		inHeap[u.vertex] = false
+
+		for _, e := range g.EdgesFrom(u.vertex) {
+			weight := e.Weight
+			if weighting != nil {
+				w, ok := weighting(u.vertex, e.To, e.Tag)
+				if !ok {
+					continue
+				}
+				weight = w
+			}
+
+			alt := distance[u.vertex] + weight
+			if distance[e.To] > alt {
+				distance[e.To] = alt
+				prev[e.To] = queueItem[TValue]{u.vertex, e.Tag, weight}
+
+				priority := addSaturating(alt, h(e.To, dest))
+				if inHeap[e.To] {
+					q.updateDistance(e.To, priority)
+				} else {
+					// e.To was already expanded but just improved: reopen it.
+					heap.Push(q, vertexDistance[TValue]{vertex: e.To, distance: priority})
+					inHeap[e.To] = true
+				}
+			}
+		}
+		heap.Init(q)
+	}
+
+	path := []PathEdge[TValue]{}
+	u := dest
+
+	for {
+		qn, ok := prev[u]
+		if !ok {
+			break
+		}
+
+		t := make([]PathEdge[TValue], len(path)+1)
+		t[0] = PathEdge[TValue]{qn.source, u, qn.weight, qn.tag}
+		copy(t[1:], path)
+		path = t
+
+		u = prev[u].source
+	}
+
+	return path, nil
+}
+
+// addSaturating adds a and b, saturating at the maximum uint value instead
+// of wrapping around. distance[v] starts at math.MaxInt to mean "infinity";
+// adding a heuristic on top of that must not wrap back around to a small
+// number.
+func addSaturating(a, b uint) uint {
+	sum := a + b
+	if sum < a {
+		return math.MaxUint
+	}
+	return sum
+}
+
+// vertexDistance implements a min-heap for calculating the shortest-path
+// between two vertices in a graph.
+type vertexDistance[T comparable] struct {
+	vertex   *Vertex[T]
+	distance uint
+}
+
+type vertexDistanceHeap[T comparable] []vertexDistance[T]
+
+func (h *vertexDistanceHeap[T]) Len() int {
+	return len(*h)
+}
+
+func (h *vertexDistanceHeap[T]) Less(i, j int) bool {
+	return (*h)[i].distance < (*h)[j].distance
+}
+
+func (h *vertexDistanceHeap[T]) Swap(i, j int) {
+	(*h)[i], (*h)[j] = (*h)[j], (*h)[i]
+}
+
+func (h *vertexDistanceHeap[T]) Push(x interface{}) {
+	*h = append(*h, x.(vertexDistance[T]))
+}
+
+func (h *vertexDistanceHeap[T]) Pop() interface{} {
+	heapSize := len(*h)
+	lastVertex := (*h)[heapSize-1]
+	*h = (*h)[0 : heapSize-1]
+	return lastVertex
+}
+
+func (h *vertexDistanceHeap[T]) updateDistance(id *Vertex[T], val uint) {
+	for i := 0; i < len(*h); i++ {
+		if (*h)[i].vertex == id {
+			(*h)[i].distance = val
+			break
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package graph
+
+import "testing"
+
+func TestAStar_NilHeuristicMatchesShortestPath(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	d := &(Vertex[string]{"D"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+	g.AddVertex(d)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(b, c, 10, nil)
+	_ = g.addEdge(a, d, 5, nil)
+	_ = g.addEdge(d, c, 5, nil)
+
+	path, err := AStar[string](&g, a, c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path[0].Source.String() != "A" || path[0].Destination.String() != "D" {
+		t.Error("Path does not contain A -> D")
+	}
+
+	if path[1].Source.String() != "D" || path[1].Destination.String() != "C" {
+		t.Error("Path does not contain D -> C")
+	}
+}
+
+func TestAStar_WithHeuristic(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(b, c, 1, nil)
+	_ = g.AddEdge(a, c, 5, nil)
+
+	h := func(n, goal *Vertex[string]) uint {
+		if n == goal {
+			return 0
+		}
+		return 1
+	}
+
+	path, err := AStar[string](&g, a, c, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(path) != 2 {
+		t.Errorf("expected the 2-hop path via B, got %v", path)
+	}
+}
+
+func TestAStar_AdmissibleButInconsistentHeuristic_StillOptimal(t *testing.T) {
+	// Regression test: an admissible heuristic that isn't consistent
+	// (monotone) used to let AStar return as soon as dest was first
+	// popped, which could be a non-optimal path. search now reopens
+	// vertices on improvement instead of trusting the first pop.
+	g := Graph[int]{}
+	vs := make([]*Vertex[int], 5)
+	for i := 0; i < 5; i++ {
+		vs[i] = &(Vertex[int]{i})
+		g.AddVertex(vs[i])
+	}
+
+	type weightedEdge struct{ src, dst, weight int }
+	edges := []weightedEdge{
+		{0, 1, 7}, {0, 2, 4},
+		{1, 0, 3}, {1, 4, 6},
+		{2, 0, 2}, {2, 1, 1}, {2, 3, 6}, {2, 4, 8},
+		{3, 1, 8}, {3, 2, 7},
+		{4, 0, 6}, {4, 3, 5},
+	}
+	for _, e := range edges {
+		if err := g.AddEdge(vs[e.src], vs[e.dst], uint(e.weight), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := []uint{6, 4, 7, 7, 0}
+	heuristic := func(n, goal *Vertex[int]) uint {
+		return h[n.value]
+	}
+
+	path, err := AStar[int](&g, vs[0], vs[4], heuristic)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cost uint
+	for _, p := range path {
+		cost += p.Weight
+	}
+
+	if cost != 11 {
+		t.Errorf("expected the true shortest cost of 11 (via 0->2->1->4), got %d along %v", cost, path)
+	}
+}
+
+func TestUniformCost_PrefersFewerHops(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 100, nil)
+	_ = g.AddEdge(b, c, 100, nil)
+	_ = g.AddEdge(a, c, 1, nil)
+
+	path, err := UniformCost[string](&g, a, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(path) != 1 || path[0].Destination.String() != "C" {
+		t.Errorf("expected the direct 1-hop path A -> C, got %v", path)
+	}
+}
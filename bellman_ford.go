@@ -0,0 +1,259 @@
+package graph
+
+import (
+	"errors"
+	"golang.org/x/exp/slices"
+	"math"
+	"sync"
+)
+
+// ErrNegativeCycle is returned by BellmanFord when a negative-weight cycle
+// is reachable from the source, which makes "shortest path" undefined.
+var ErrNegativeCycle = errors.New("negative cycle reachable from source")
+
+// Graph itself is unaffected by the addition of SignedGraph below: its
+// uint weights and existing API keep working exactly as before for callers
+// that don't need negative edges.
+
+// signedWeightedEdge mirrors weightedEdge, but with a signed weight:
+// Bellman-Ford (and, eventually, Johnson's algorithm) needs negative edges,
+// which Graph's uint weight can't represent.
+type signedWeightedEdge[TValue comparable] struct {
+	destination *Vertex[TValue]
+	weight      int
+	tag         *string
+}
+
+// SignedPathEdge mirrors PathEdge, but with a signed weight.
+type SignedPathEdge[TValue comparable] struct {
+	Source      *Vertex[TValue]
+	Destination *Vertex[TValue]
+	Weight      int
+	Tag         *string
+}
+
+// SignedGraph is a directed, weighted graph like Graph, except edge weights
+// are signed, which allows negative edges for algorithms like BellmanFord.
+type SignedGraph[TValue comparable] struct {
+	vertices []*Vertex[TValue]
+	edges    map[Vertex[TValue]][]signedWeightedEdge[TValue]
+	lock     sync.RWMutex
+}
+
+// AddVertex adds a vertex to the graph without any edges. If the vertex
+// already exists, no action is taken.
+func (g *SignedGraph[TValue]) AddVertex(v *Vertex[TValue]) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !slices.Contains(g.vertices, v) {
+		g.vertices = append(g.vertices, v)
+	}
+}
+
+// ContainsVertex checks if the graph contains a vertex.
+func (g *SignedGraph[TValue]) ContainsVertex(v *Vertex[TValue]) bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return slices.Contains(g.vertices, v)
+}
+
+// AddEdge creates a directed edge from src->dest with a non-zero, possibly
+// negative weight and an optional tag. Supply `nil` if there's no tag.
+func (g *SignedGraph[TValue]) AddEdge(src, dest *Vertex[TValue], weight int, tag *string) error {
+	if weight == 0 {
+		return errors.New("weight cannot be 0")
+	}
+
+	if src == nil {
+		return errors.New("src cannot be nil")
+	}
+
+	if dest == nil {
+		return errors.New("dest cannot be nil")
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !slices.Contains(g.vertices, src) {
+		return errors.New("unable to locate src in graph")
+	}
+
+	if !slices.Contains(g.vertices, dest) {
+		return errors.New("unable to locate dest in graph")
+	}
+
+	if g.containsEdge(src, dest, tag) {
+		return errors.New("this edge is already present")
+	}
+
+	if g.edges == nil {
+		g.edges = make(map[Vertex[TValue]][]signedWeightedEdge[TValue])
+	}
+
+	g.edges[*src] = append(g.edges[*src], signedWeightedEdge[TValue]{dest, weight, tag})
+
+	return nil
+}
+
+// RemoveEdge removes only the edge src->dest.
+func (g *SignedGraph[TValue]) RemoveEdge(src, dest *Vertex[TValue], tag *string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !g.containsEdge(src, dest, tag) {
+		return
+	}
+
+	f := func(e signedWeightedEdge[TValue]) bool {
+		return e.destination == dest
+	}
+
+	if idx := slices.IndexFunc(g.edges[*src], f); idx >= 0 {
+		g.edges[*src] = slices.Delete(g.edges[*src], idx, idx+1)
+	}
+}
+
+// ContainsEdge checks if the graph contains the edge src->dest.
+func (g *SignedGraph[TValue]) ContainsEdge(src, dest *Vertex[TValue], tag *string) bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.containsEdge(src, dest, tag)
+}
+
+func (g *SignedGraph[TValue]) containsEdge(src, dest *Vertex[TValue], tag *string) bool {
+	edges, exists := g.edges[*src]
+	if !exists {
+		return false
+	}
+
+	for _, edge := range edges {
+		if edge.destination != dest {
+			continue
+		}
+
+		if edge.tag == nil && tag == nil {
+			return true
+		}
+
+		if edge.tag != nil && tag != nil && *edge.tag == *tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+type signedQueueItem[TValue comparable] struct {
+	source *Vertex[TValue]
+	tag    *string
+	weight int
+}
+
+// SignedShortestPaths is the BellmanFord counterpart to ShortestPaths: a
+// reusable single-source shortest-path result over signed weights.
+type SignedShortestPaths[TValue comparable] struct {
+	src      *Vertex[TValue]
+	distance map[*Vertex[TValue]]int
+	prev     map[*Vertex[TValue]]signedQueueItem[TValue]
+}
+
+// Distances returns the shortest known distance from the source to every
+// vertex reached during the search.
+func (sp *SignedShortestPaths[TValue]) Distances() map[*Vertex[TValue]]int {
+	out := make(map[*Vertex[TValue]]int, len(sp.distance))
+	for v, d := range sp.distance {
+		if d == math.MaxInt {
+			continue
+		}
+		out[v] = d
+	}
+
+	return out
+}
+
+// To reconstructs the shortest path from the source to dest, along with its
+// total distance. The bool is false if dest is unreachable from the source.
+func (sp *SignedShortestPaths[TValue]) To(dest *Vertex[TValue]) ([]SignedPathEdge[TValue], int, bool) {
+	distance, ok := sp.distance[dest]
+	if !ok || distance == math.MaxInt {
+		return nil, 0, false
+	}
+
+	if dest == sp.src {
+		return []SignedPathEdge[TValue]{}, 0, true
+	}
+
+	path := []SignedPathEdge[TValue]{}
+	u := dest
+
+	for {
+		qn, ok := sp.prev[u]
+		if !ok {
+			break
+		}
+
+		t := make([]SignedPathEdge[TValue], len(path)+1)
+		t[0] = SignedPathEdge[TValue]{qn.source, u, qn.weight, qn.tag}
+		copy(t[1:], path)
+		path = t
+
+		u = qn.source
+	}
+
+	return path, distance, true
+}
+
+// BellmanFord computes shortest paths from src using the Bellman-Ford
+// algorithm, which (unlike Dijkstra/AStar) tolerates negative edge weights.
+// It relaxes every edge |V|-1 times using the adjacency map in g.edges,
+// then does one more pass to detect a negative cycle reachable from src,
+// returning ErrNegativeCycle if one exists.
+func (g *SignedGraph[TValue]) BellmanFord(src *Vertex[TValue]) (*SignedShortestPaths[TValue], error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	distance := make(map[*Vertex[TValue]]int, len(g.vertices))
+	prev := make(map[*Vertex[TValue]]signedQueueItem[TValue])
+
+	for _, v := range g.vertices {
+		if v == src {
+			distance[v] = 0
+		} else {
+			distance[v] = math.MaxInt
+		}
+	}
+
+	for i := 0; i < len(g.vertices)-1; i++ {
+		for _, u := range g.vertices {
+			if distance[u] == math.MaxInt {
+				continue
+			}
+
+			for _, e := range g.edges[*u] {
+				alt := distance[u] + e.weight
+				if alt < distance[e.destination] {
+					distance[e.destination] = alt
+					prev[e.destination] = signedQueueItem[TValue]{u, e.tag, e.weight}
+				}
+			}
+		}
+	}
+
+	for _, u := range g.vertices {
+		if distance[u] == math.MaxInt {
+			continue
+		}
+
+		for _, e := range g.edges[*u] {
+			if distance[u]+e.weight < distance[e.destination] {
+				return nil, ErrNegativeCycle
+			}
+		}
+	}
+
+	return &SignedShortestPaths[TValue]{src: src, distance: distance, prev: prev}, nil
+}
@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSignedGraph_BellmanFord_NegativeWeights(t *testing.T) {
+	g := SignedGraph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 5, nil)
+	_ = g.AddEdge(a, c, 10, nil)
+	_ = g.AddEdge(b, c, -2, nil)
+
+	paths, err := g.BellmanFord(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, distance, ok := paths.To(c)
+	if !ok {
+		t.Fatal("expected C to be reachable from A")
+	}
+
+	if distance != 3 {
+		t.Errorf("expected distance 3 (via B), got %d", distance)
+	}
+
+	if path[0].Destination.String() != "B" || path[1].Destination.String() != "C" {
+		t.Errorf("expected path A -> B -> C, got %v", path)
+	}
+}
+
+func TestSignedGraph_BellmanFord_NegativeCycle(t *testing.T) {
+	g := SignedGraph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(b, c, -1, nil)
+	_ = g.AddEdge(c, b, -1, nil)
+
+	_, err := g.BellmanFord(a)
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("expected ErrNegativeCycle, got %v", err)
+	}
+}
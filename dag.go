@@ -0,0 +1,125 @@
+package graph
+
+import "errors"
+
+// ErrCycle is returned by DAG when an edge would introduce a cycle.
+var ErrCycle = errors.New("edge would create a cycle")
+
+// DAG is a Graph[TValue] that guarantees acyclicity: AddEdge and
+// AddSymmetricEdge refuse any edge that would introduce a cycle, returning
+// ErrCycle instead.
+type DAG[TValue comparable] struct {
+	Graph[TValue]
+}
+
+// NewDAG creates an empty, acyclic graph.
+func NewDAG[TValue comparable]() *DAG[TValue] {
+	return &DAG[TValue]{}
+}
+
+// AddEdge creates a directed edge from src->dest, refusing it with ErrCycle
+// if dest can already reach src (which would close a cycle).
+func (d *DAG[TValue]) AddEdge(src, dest *Vertex[TValue], weight uint, tag *string) error {
+	if err := d.isEdgeValid(src, dest, weight); err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.reaches(dest, src) {
+		return ErrCycle
+	}
+
+	return d.addEdge(src, dest, weight, tag)
+}
+
+// AddSymmetricEdge returns ErrCycle for any otherwise valid edge: an edge in
+// both directions between two vertices is, by definition, a cycle. An
+// invalid edge (zero weight, nil or unknown vertex) gets the same error as
+// from AddEdge instead.
+func (d *DAG[TValue]) AddSymmetricEdge(src, dest *Vertex[TValue], weight uint, tag *string) error {
+	if err := d.isEdgeValid(src, dest, weight); err != nil {
+		return err
+	}
+
+	return ErrCycle
+}
+
+// reaches reports whether there is a path from `from` to `to`, including the
+// trivial case from == to. It is used to detect whether adding to->from
+// would create a cycle.
+func (d *DAG[TValue]) reaches(from, to *Vertex[TValue]) bool {
+	if from == to {
+		return true
+	}
+
+	seen := make(map[*Vertex[TValue]]bool)
+	stack := []*Vertex[TValue]{from}
+
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+
+		if n == to {
+			return true
+		}
+
+		for _, e := range d.edges[*n] {
+			stack = append(stack, e.destination)
+		}
+	}
+
+	return false
+}
+
+// TopologicalSort returns the vertices of the DAG in a topological order
+// using Kahn's algorithm over the adjacency map in Graph.edges. It returns
+// ErrCycle if a cycle is somehow present (which AddEdge/AddSymmetricEdge
+// should already prevent).
+func (d *DAG[TValue]) TopologicalSort() ([]*Vertex[TValue], error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	inDegree := make(map[*Vertex[TValue]]int, len(d.vertices))
+	for _, v := range d.vertices {
+		inDegree[v] = 0
+	}
+	for _, v := range d.vertices {
+		for _, e := range d.edges[*v] {
+			inDegree[e.destination]++
+		}
+	}
+
+	queue := make([]*Vertex[TValue], 0, len(d.vertices))
+	for _, v := range d.vertices {
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	order := make([]*Vertex[TValue], 0, len(d.vertices))
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		order = append(order, u)
+
+		for _, e := range d.edges[*u] {
+			inDegree[e.destination]--
+			if inDegree[e.destination] == 0 {
+				queue = append(queue, e.destination)
+			}
+		}
+	}
+
+	if len(order) != len(d.vertices) {
+		return nil, ErrCycle
+	}
+
+	return order, nil
+}
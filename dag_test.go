@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDAG_AddEdge_RejectsCycle(t *testing.T) {
+	d := NewDAG[string]()
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	d.AddVertex(a)
+	d.AddVertex(b)
+	d.AddVertex(c)
+
+	if err := d.AddEdge(a, b, 1, nil); err != nil {
+		t.Error(err)
+	}
+
+	if err := d.AddEdge(b, c, 1, nil); err != nil {
+		t.Error(err)
+	}
+
+	err := d.AddEdge(c, a, 1, nil)
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("expected ErrCycle, got %v", err)
+	}
+}
+
+func TestDAG_AddSymmetricEdge_AlwaysCycle(t *testing.T) {
+	d := NewDAG[string]()
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	d.AddVertex(a)
+	d.AddVertex(b)
+
+	err := d.AddSymmetricEdge(a, b, 1, nil)
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("expected ErrCycle, got %v", err)
+	}
+}
+
+func TestDAG_TopologicalSort(t *testing.T) {
+	d := NewDAG[string]()
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	d.AddVertex(a)
+	d.AddVertex(b)
+	d.AddVertex(c)
+
+	_ = d.AddEdge(a, b, 1, nil)
+	_ = d.AddEdge(b, c, 1, nil)
+
+	order, err := d.TopologicalSort()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index := make(map[*Vertex[string]]int, len(order))
+	for i, v := range order {
+		index[v] = i
+	}
+
+	if index[a] >= index[b] || index[b] >= index[c] {
+		t.Errorf("expected order A, B, C; got %v", order)
+	}
+}
@@ -1,11 +1,9 @@
 package graph
 
 import (
-	"container/heap"
 	"errors"
 	"fmt"
 	"golang.org/x/exp/slices"
-	"math"
 	"sync"
 )
 
@@ -298,170 +296,54 @@ func (g *Graph[TValue]) containsEdge(src, dest *Vertex[TValue], tag *string) boo
 	return false
 }
 
-// ShortestPath is an implementation of Dijkstra's algorithm for a single
-// src->dest route.
-func (g *Graph[TValue]) ShortestPath(src, dest *Vertex[TValue]) ([]PathEdge[TValue], error) {
+// Vertices returns a snapshot of every vertex in the graph. It is part of
+// the WeightedGraph interface.
+func (g *Graph[TValue]) Vertices() []*Vertex[TValue] {
 	g.lock.RLock()
 	defer g.lock.RUnlock()
 
-	return g.shortestPath(src, dest)
-}
+	vertices := make([]*Vertex[TValue], len(g.vertices))
+	copy(vertices, g.vertices)
 
-type queueItem[TValue comparable] struct {
-	source *Vertex[TValue]
-	tag    *string
-	weight uint
+	return vertices
 }
 
-// shortestPath is an implementation of Dijkstra's algorithm
-//
-// Wikipedia claims:
-//
-//	1  function Dijkstra(Graph, source, target):
-//	2
-//	3      for each vertex v in Graph.Vertices:
-//	4          dist[v] ← INFINITY
-//	5          prev[v] ← UNDEFINED
-//	6          add v to Q
-//	7      dist[source] ← 0
-//	8
-//	9      while Q is not empty:
-//
-// 10          u ← vertex in Q with min dist[u]
-//
-//	if u = target
-//	   break
-//
-// 11          remove u from Q
-// 12
-// 13          for each neighbor v of u still in Q:
-// 14              alt ← dist[u] + Graph.Edges(u, v)
-// 15              if alt < dist[v]:
-// 16                  dist[v] ← alt
-// 17                  prev[v] ← u
-// 18
-//
-//	1  S ← empty sequence
-//	2  u ← target
-//	3  if prev[u] is defined or u = source:          // Do something only if the vertex is reachable
-//	4      while u is defined:                       // Construct the shortest path with a stack S
-//	5          insert u at the beginning of S        // Push the vertex onto the stack
-//	6          u ← prev[u]                           // Traverse from target to source
-func (g *Graph[TValue]) shortestPath(src, dest *Vertex[TValue]) ([]PathEdge[TValue], error) {
-
-	// Set the distance to src to 0
-	distance := make(map[*Vertex[TValue]]uint)
-	distance[src] = 0
-
-	// create a vertex priority queue
-	q := &vertexDistanceHeap[TValue]{}
-
-	// for each vertex v in Graph.Vertices:
-	for _, v := range g.vertices {
-		if *v != *src {
-			// dist[v] ← INFINITY
-			distance[v] = math.MaxInt
-			// skipping prev[v] ← UNDEFINED
-		}
+// EdgesFrom returns a snapshot of v's outgoing edges. It is part of the
+// WeightedGraph interface.
+func (g *Graph[TValue]) EdgesFrom(v *Vertex[TValue]) []Edge[TValue] {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
 
-		// Q.add_with_priority(v, dist[v])
-		heap.Push(q, vertexDistance[TValue]{vertex: v, distance: distance[v]})
+	es := g.edges[*v]
+	out := make([]Edge[TValue], len(es))
+	for i, e := range es {
+		out[i] = Edge[TValue]{e.destination, e.weight, e.tag}
 	}
 
-	prev := make(map[*Vertex[TValue]]queueItem[TValue])
-
-	// while Q is not empty:
-	for q.Len() != 0 {
-		// u ← vertex in Q with min dist[u]
-		u := heap.Pop(q).(vertexDistance[TValue])
+	return out
+}
 
-		//
-		if u.vertex == dest {
-			break
-		}
+// Weight returns the weight of the edge src->dest (if any). It is part of
+// the WeightedGraph interface.
+func (g *Graph[TValue]) Weight(src, dest *Vertex[TValue], tag *string) (uint, bool) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
 
-		neighbors := g.edges[*u.vertex]
-		// for each neighbor v of u
-		for _, uToV := range neighbors {
-			v := uToV.destination
-			// alt ← dist[u] + Graph.Edges(u, v)
-			alt := distance[u.vertex] + uToV.weight
-			if distance[v] > alt {
-				// dist[v] ← alt
-				distance[v] = alt
-				// prev[v] ← u
-				prev[v] = queueItem[TValue]{u.vertex, uToV.tag, uToV.weight}
-				// Q.decrease_priority(v, alt)
-				q.updateDistance(v, alt)
-			}
+	for _, e := range g.edges[*src] {
+		if e.destination != dest {
+			continue
 		}
-		heap.Init(q)
-	}
 
-	// And now we build up the shortest path!
-
-	// S ← empty sequence
-	path := []PathEdge[TValue]{}
-	// u ← target
-	u := dest
-
-	for {
-		// if prev[u] is defined or u = source:
-		qn, ok := prev[u]
-		if !ok {
-			break
+		if (e.tag == nil && tag == nil) || (e.tag != nil && tag != nil && *e.tag == *tag) {
+			return e.weight, true
 		}
-
-		// insert u at the beginning of S
-		t := make([]PathEdge[TValue], len(path)+1)
-		t[0] = PathEdge[TValue]{qn.source, u, qn.weight, qn.tag}
-		copy(t[1:], path)
-		path = t
-
-		// u ← prev[u]
-		u = prev[u].source
 	}
 
-	return path, nil
+	return 0, false
 }
 
-// vertexDistance implements a min-heap for calculating the shortest-path between
-// two vertices in a graph
-type vertexDistance[T comparable] struct {
-	vertex   *Vertex[T]
-	distance uint
-}
-
-type vertexDistanceHeap[T comparable] []vertexDistance[T]
-
-func (h *vertexDistanceHeap[T]) Len() int {
-	return len(*h)
-}
-
-func (h *vertexDistanceHeap[T]) Less(i, j int) bool {
-	return (*h)[i].distance < (*h)[j].distance
-}
-
-func (h *vertexDistanceHeap[T]) Swap(i, j int) {
-	(*h)[i], (*h)[j] = (*h)[j], (*h)[i]
-}
-
-func (h *vertexDistanceHeap[T]) Push(x interface{}) {
-	*h = append(*h, x.(vertexDistance[T]))
-}
-
-func (h *vertexDistanceHeap[T]) Pop() interface{} {
-	heapSize := len(*h)
-	lastVertex := (*h)[heapSize-1]
-	*h = (*h)[0 : heapSize-1]
-	return lastVertex
-}
-
-func (h *vertexDistanceHeap[T]) updateDistance(id *Vertex[T], val uint) {
-	for i := 0; i < len(*h); i++ {
-		if (*h)[i].vertex == id {
-			(*h)[i].distance = val
-			break
-		}
-	}
+// ShortestPath is an implementation of Dijkstra's algorithm for a single
+// src->dest route. It is equivalent to AStar with NullHeuristic.
+func (g *Graph[TValue]) ShortestPath(src, dest *Vertex[TValue]) ([]PathEdge[TValue], error) {
+	return AStar[TValue](g, src, dest, nil)
 }
@@ -0,0 +1,217 @@
+package graph
+
+import (
+	"fmt"
+	"sync"
+)
+
+// VertexIndex identifies a vertex in an IndexedGraph by its position in the
+// backing slice.
+type VertexIndex int
+
+// EdgeIndex identifies an edge in an IndexedGraph by its position in the
+// backing slice.
+type EdgeIndex int
+
+// noEdge marks the end of an adjacency list (there is no such edge).
+const noEdge EdgeIndex = -1
+
+// vertexNode is the per-vertex record in an IndexedGraph: the vertex's
+// value plus the head of its outgoing/incoming adjacency lists.
+type vertexNode[TValue comparable] struct {
+	value        TValue
+	firstOutEdge EdgeIndex
+	firstInEdge  EdgeIndex
+}
+
+// edgeNode is the per-edge record in an IndexedGraph. nextOutEdge and
+// nextInEdge link to the next edge sharing this edge's source/destination
+// respectively, forming singly-linked adjacency lists rooted at
+// vertexNode.firstOutEdge/firstInEdge.
+type edgeNode[TValue comparable] struct {
+	source      VertexIndex
+	destination VertexIndex
+	weight      uint
+	tag         *string
+	nextOutEdge EdgeIndex
+	nextInEdge  EdgeIndex
+}
+
+// IndexedGraph is a directed, weighted graph backed by index-based
+// adjacency lists, as in petgraph's graph types, rather than the
+// map[Vertex[TValue]][]weightedEdge[TValue] that Graph uses. Vertices and
+// edges live in flat slices and are looked up by VertexIndex/EdgeIndex in
+// O(1), and iteration over an adjacency list is a cache-friendly linked
+// walk instead of a map hash per step — useful for algorithms like
+// Dijkstra run repeatedly over large graphs.
+// IndexedGraph guards its slices with the same sync.RWMutex pattern as
+// Graph, SignedGraph, and UndirectedGraph: concurrent AddVertex/AddEdge
+// calls mutate shared slices and, unguarded, would race.
+//
+// Unlike Graph/SignedGraph/UndirectedGraph, whose methods validate
+// endpoints and return a plain error, IndexedGraph trades that safety net
+// for the O(1) lookups it exists for: every VertexIndex/EdgeIndex accessor
+// below (AddEdge, Vertex, Edge, OutEdges, InEdges) indexes straight into
+// the backing slices and panics, like a plain slice index, on an
+// out-of-range index. Callers are expected to only pass indices returned
+// by this graph's own AddVertex/AddEdge.
+type IndexedGraph[TValue comparable] struct {
+	vertices []vertexNode[TValue]
+	edgesArr []edgeNode[TValue]
+	lock     sync.RWMutex
+}
+
+// AddVertex appends a new vertex and returns its index.
+func (g *IndexedGraph[TValue]) AddVertex(value TValue) VertexIndex {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.vertices = append(g.vertices, vertexNode[TValue]{value: value, firstOutEdge: noEdge, firstInEdge: noEdge})
+	return VertexIndex(len(g.vertices) - 1)
+}
+
+// Vertex returns the value stored at index i. It panics if i is out of
+// range.
+func (g *IndexedGraph[TValue]) Vertex(i VertexIndex) TValue {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.vertices[i].value
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g *IndexedGraph[TValue]) VertexCount() int {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return len(g.vertices)
+}
+
+// AddEdge adds a directed edge from src to dest with the given weight and
+// optional tag, and returns its index. It prepends to both endpoints'
+// adjacency lists, so AddEdge is O(1). Unlike Graph.AddEdge, there's no
+// uniqueness constraint: parallel edges between the same (src, dest, tag)
+// are allowed. It panics if src or dest is out of range.
+func (g *IndexedGraph[TValue]) AddEdge(src, dest VertexIndex, weight uint, tag *string) EdgeIndex {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	idx := EdgeIndex(len(g.edgesArr))
+
+	g.edgesArr = append(g.edgesArr, edgeNode[TValue]{
+		source:      src,
+		destination: dest,
+		weight:      weight,
+		tag:         tag,
+		nextOutEdge: g.vertices[src].firstOutEdge,
+		nextInEdge:  g.vertices[dest].firstInEdge,
+	})
+
+	g.vertices[src].firstOutEdge = idx
+	g.vertices[dest].firstInEdge = idx
+
+	return idx
+}
+
+// Edge returns edge i's endpoints, weight, and tag. It panics if i is out
+// of range.
+func (g *IndexedGraph[TValue]) Edge(i EdgeIndex) (src, dest VertexIndex, weight uint, tag *string) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	e := g.edgesArr[i]
+	return e.source, e.destination, e.weight, e.tag
+}
+
+// OutEdges returns the indices of every edge leaving v, in reverse
+// insertion order, by walking v's outgoing adjacency list. It panics if v
+// is out of range.
+func (g *IndexedGraph[TValue]) OutEdges(v VertexIndex) []EdgeIndex {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.walk(g.vertices[v].firstOutEdge, func(e edgeNode[TValue]) EdgeIndex {
+		return e.nextOutEdge
+	})
+}
+
+// InEdges returns the indices of every edge arriving at v, in reverse
+// insertion order, by walking v's incoming adjacency list. It panics if v
+// is out of range.
+func (g *IndexedGraph[TValue]) InEdges(v VertexIndex) []EdgeIndex {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.walk(g.vertices[v].firstInEdge, func(e edgeNode[TValue]) EdgeIndex {
+		return e.nextInEdge
+	})
+}
+
+func (g *IndexedGraph[TValue]) walk(head EdgeIndex, next func(edgeNode[TValue]) EdgeIndex) []EdgeIndex {
+	out := make([]EdgeIndex, 0)
+	for i := head; i != noEdge; i = next(g.edgesArr[i]) {
+		out = append(out, i)
+	}
+	return out
+}
+
+// ToGraph converts an IndexedGraph to a map-backed Graph, along with the
+// *Vertex[TValue] created for each VertexIndex (index i of the returned
+// slice is the vertex for VertexIndex(i)), so that callers who need
+// Graph's pointer-identity API (ShortestPath, DAG, etc.) can switch back.
+//
+// IndexedGraph allows parallel edges (same src, dest, and tag), since its
+// linked adjacency lists have no uniqueness constraint, but Graph.AddEdge
+// rejects a second edge with the same (src, dest, tag). If g has any such
+// parallel edges, only one of each survives the conversion, and ToGraph
+// returns a non-nil error reporting how many were dropped; the returned
+// Graph and vertices are still usable otherwise.
+func (g *IndexedGraph[TValue]) ToGraph() (*Graph[TValue], []*Vertex[TValue], error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	out := &Graph[TValue]{}
+
+	vertices := make([]*Vertex[TValue], len(g.vertices))
+	for i, vn := range g.vertices {
+		v := &Vertex[TValue]{vn.value}
+		vertices[i] = v
+		out.AddVertex(v)
+	}
+
+	dropped := 0
+	for _, e := range g.edgesArr {
+		if err := out.AddEdge(vertices[e.source], vertices[e.destination], e.weight, e.tag); err != nil {
+			dropped++
+		}
+	}
+
+	if dropped > 0 {
+		return out, vertices, fmt.Errorf("ToGraph: dropped %d parallel edge(s) not representable in Graph", dropped)
+	}
+
+	return out, vertices, nil
+}
+
+// NewIndexedGraphFromGraph converts a map-backed Graph into an
+// IndexedGraph, along with the VertexIndex assigned to each of Graph's
+// existing *Vertex[TValue] pointers, so callers can move a graph built with
+// Graph's pointer-identity API onto the index-based representation for
+// performance-sensitive workloads.
+func NewIndexedGraphFromGraph[TValue comparable](g *Graph[TValue]) (*IndexedGraph[TValue], map[*Vertex[TValue]]VertexIndex) {
+	out := &IndexedGraph[TValue]{}
+
+	vertices := g.Vertices()
+	index := make(map[*Vertex[TValue]]VertexIndex, len(vertices))
+	for _, v := range vertices {
+		index[v] = out.AddVertex(v.value)
+	}
+
+	for _, v := range vertices {
+		for _, e := range g.EdgesFrom(v) {
+			out.AddEdge(index[v], index[e.To], e.Weight, e.Tag)
+		}
+	}
+
+	return out, index
+}
@@ -0,0 +1,93 @@
+package graph
+
+import "testing"
+
+func TestIndexedGraph_AddEdgeAndOutEdges(t *testing.T) {
+	g := IndexedGraph[string]{}
+	a := g.AddVertex("A")
+	b := g.AddVertex("B")
+	c := g.AddVertex("C")
+
+	g.AddEdge(a, b, 1, nil)
+	g.AddEdge(a, c, 2, nil)
+
+	out := g.OutEdges(a)
+	if len(out) != 2 {
+		t.Fatalf("expected A to have 2 outgoing edges, got %d", len(out))
+	}
+
+	_, dest, weight, _ := g.Edge(out[0])
+	if g.Vertex(dest) != "C" || weight != 2 {
+		t.Errorf("expected the most recently added edge first, got dest=%v weight=%d", g.Vertex(dest), weight)
+	}
+}
+
+func TestIndexedGraph_InEdges(t *testing.T) {
+	g := IndexedGraph[string]{}
+	a := g.AddVertex("A")
+	b := g.AddVertex("B")
+	c := g.AddVertex("C")
+
+	g.AddEdge(a, c, 1, nil)
+	g.AddEdge(b, c, 1, nil)
+
+	in := g.InEdges(c)
+	if len(in) != 2 {
+		t.Fatalf("expected C to have 2 incoming edges, got %d", len(in))
+	}
+}
+
+func TestIndexedGraph_ToGraphRoundTrip(t *testing.T) {
+	ig := IndexedGraph[string]{}
+	a := ig.AddVertex("A")
+	b := ig.AddVertex("B")
+	ig.AddEdge(a, b, 5, nil)
+
+	g, vertices, err := ig.ToGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !g.ContainsEdge(vertices[a], vertices[b], nil) {
+		t.Error("expected the converted Graph to contain A -> B")
+	}
+}
+
+func TestIndexedGraph_ToGraph_ReportsDroppedParallelEdges(t *testing.T) {
+	ig := IndexedGraph[string]{}
+	a := ig.AddVertex("A")
+	b := ig.AddVertex("B")
+	ig.AddEdge(a, b, 5, nil)
+	ig.AddEdge(a, b, 7, nil)
+
+	g, vertices, err := ig.ToGraph()
+	if err == nil {
+		t.Fatal("expected an error reporting the dropped parallel edge")
+	}
+
+	edges := g.EdgesFrom(vertices[a])
+	if len(edges) != 1 {
+		t.Errorf("expected exactly 1 surviving edge, got %d", len(edges))
+	}
+}
+
+func TestNewIndexedGraphFromGraph(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	_ = g.AddEdge(a, b, 5, nil)
+
+	ig, index := NewIndexedGraphFromGraph[string](&g)
+
+	out := ig.OutEdges(index[a])
+	if len(out) != 1 {
+		t.Fatalf("expected A to have 1 outgoing edge, got %d", len(out))
+	}
+
+	_, dest, weight, _ := ig.Edge(out[0])
+	if ig.Vertex(dest) != "B" || weight != 5 {
+		t.Errorf("expected A -> B weight 5, got dest=%v weight=%d", ig.Vertex(dest), weight)
+	}
+}
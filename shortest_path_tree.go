@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"container/heap"
+	"math"
+)
+
+// ShortestPaths is a reusable single-source shortest-path result: the
+// output of running Dijkstra once from a source vertex to completion. It
+// retains the distance/prev maps built along the way so that To(dest) can
+// reconstruct any path afterwards without recomputing the search, unlike
+// repeated calls to ShortestPath/AStar from the same source.
+type ShortestPaths[TValue comparable] struct {
+	src      *Vertex[TValue]
+	distance map[*Vertex[TValue]]uint
+	prev     map[*Vertex[TValue]]queueItem[TValue]
+}
+
+// Distances returns the shortest known distance from the source to every
+// vertex reached during the search.
+func (sp *ShortestPaths[TValue]) Distances() map[*Vertex[TValue]]uint {
+	out := make(map[*Vertex[TValue]]uint, len(sp.distance))
+	for v, d := range sp.distance {
+		if d == math.MaxInt {
+			continue
+		}
+		out[v] = d
+	}
+
+	return out
+}
+
+// To lazily reconstructs the shortest path from the source to dest from the
+// retained prev map, along with its total distance. The bool is false if
+// dest is unreachable from the source.
+func (sp *ShortestPaths[TValue]) To(dest *Vertex[TValue]) ([]PathEdge[TValue], uint, bool) {
+	distance, ok := sp.distance[dest]
+	if !ok || distance == math.MaxInt {
+		return nil, 0, false
+	}
+
+	if dest == sp.src {
+		return []PathEdge[TValue]{}, 0, true
+	}
+
+	path := []PathEdge[TValue]{}
+	u := dest
+
+	for {
+		qn, ok := sp.prev[u]
+		if !ok {
+			break
+		}
+
+		t := make([]PathEdge[TValue], len(path)+1)
+		t[0] = PathEdge[TValue]{qn.source, u, qn.weight, qn.tag}
+		copy(t[1:], path)
+		path = t
+
+		u = qn.source
+	}
+
+	return path, distance, true
+}
+
+// ShortestPathTree runs Dijkstra's algorithm once from src, to completion,
+// and returns a reusable ShortestPaths result for querying any number of
+// destinations without recomputing the search (AStar/ShortestPath also
+// search to completion, but return only the path to a single destination).
+func ShortestPathTree[TValue comparable](g WeightedGraph[TValue], src *Vertex[TValue]) (*ShortestPaths[TValue], error) {
+	vertices := g.Vertices()
+
+	distance := make(map[*Vertex[TValue]]uint, len(vertices))
+	distance[src] = 0
+
+	q := &vertexDistanceHeap[TValue]{}
+	for _, v := range vertices {
+		if v != src {
+			distance[v] = math.MaxInt
+		}
+
+		heap.Push(q, vertexDistance[TValue]{vertex: v, distance: distance[v]})
+	}
+
+	prev := make(map[*Vertex[TValue]]queueItem[TValue])
+
+	for q.Len() != 0 {
+		u := heap.Pop(q).(vertexDistance[TValue])
+
+		for _, e := range g.EdgesFrom(u.vertex) {
+			alt := distance[u.vertex] + e.Weight
+			if distance[e.To] > alt {
+				distance[e.To] = alt
+				prev[e.To] = queueItem[TValue]{u.vertex, e.Tag, e.Weight}
+				q.updateDistance(e.To, alt)
+			}
+		}
+		heap.Init(q)
+	}
+
+	return &ShortestPaths[TValue]{src: src, distance: distance, prev: prev}, nil
+}
+
+// AllPairsShortestPaths computes a ShortestPaths tree rooted at every
+// vertex in g, by running ShortestPathTree once per vertex. For dense
+// graphs, a direct Floyd-Warshall implementation would avoid the
+// per-vertex heap overhead, but this reuses the same incremental-tree
+// building block as ShortestPathTree.
+func AllPairsShortestPaths[TValue comparable](g WeightedGraph[TValue]) (map[*Vertex[TValue]]*ShortestPaths[TValue], error) {
+	vertices := g.Vertices()
+
+	trees := make(map[*Vertex[TValue]]*ShortestPaths[TValue], len(vertices))
+	for _, v := range vertices {
+		tree, err := ShortestPathTree[TValue](g, v)
+		if err != nil {
+			return nil, err
+		}
+		trees[v] = tree
+	}
+
+	return trees, nil
+}
+
+// ShortestPathTree runs Dijkstra's algorithm once from src and returns a
+// reusable ShortestPaths result. See the package-level ShortestPathTree for
+// details.
+func (g *Graph[TValue]) ShortestPathTree(src *Vertex[TValue]) (*ShortestPaths[TValue], error) {
+	return ShortestPathTree[TValue](g, src)
+}
+
+// AllPairsShortestPaths computes a ShortestPaths tree rooted at every
+// vertex in the graph. See the package-level AllPairsShortestPaths for
+// details.
+func (g *Graph[TValue]) AllPairsShortestPaths() (map[*Vertex[TValue]]*ShortestPaths[TValue], error) {
+	return AllPairsShortestPaths[TValue](g)
+}
@@ -0,0 +1,87 @@
+package graph
+
+import "testing"
+
+func TestShortestPathTree(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	d := &(Vertex[string]{"D"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+	g.AddVertex(d)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(b, c, 10, nil)
+	_ = g.addEdge(a, d, 5, nil)
+	_ = g.addEdge(d, c, 5, nil)
+
+	tree, err := g.ShortestPathTree(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, distance, ok := tree.To(c)
+	if !ok {
+		t.Fatal("expected C to be reachable from A")
+	}
+
+	if distance != 10 {
+		t.Errorf("expected distance 10, got %d", distance)
+	}
+
+	if path[0].Destination.String() != "D" || path[1].Destination.String() != "C" {
+		t.Errorf("expected path via D, got %v", path)
+	}
+
+	distances := tree.Distances()
+	if distances[b] != 1 {
+		t.Errorf("expected distance to B to be 1, got %d", distances[b])
+	}
+}
+
+func TestShortestPathTree_Unreachable(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+
+	tree, err := g.ShortestPathTree(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, ok := tree.To(b); ok {
+		t.Error("expected B to be unreachable from A")
+	}
+}
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	g := Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(b, c, 1, nil)
+
+	trees, err := g.AllPairsShortestPaths()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, distance, ok := trees[a].To(c)
+	if !ok || distance != 2 {
+		t.Errorf("expected distance from A to C to be 2, got %d (ok=%v)", distance, ok)
+	}
+
+	if _, _, ok := trees[c].To(a); ok {
+		t.Error("expected A to be unreachable from C in a directed graph")
+	}
+}
@@ -0,0 +1,120 @@
+package graph
+
+import "errors"
+
+// BFS walks the graph breadth-first from src, calling visit for each
+// discovered vertex along with its depth (in edges) from src. Returning
+// false from visit prunes that vertex's neighbors from the walk, without
+// stopping the rest of the traversal.
+func (g *Graph[TValue]) BFS(src *Vertex[TValue], visit func(v *Vertex[TValue], depth int) bool) error {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	if src == nil {
+		return errors.New("src cannot be nil")
+	}
+
+	if !g.containsVertex(src) {
+		return errors.New("graph does not contain src")
+	}
+
+	type queued struct {
+		vertex *Vertex[TValue]
+		depth  int
+	}
+
+	seen := map[*Vertex[TValue]]bool{src: true}
+	queue := []queued{{src, 0}}
+
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+
+		if !visit(u.vertex, u.depth) {
+			continue
+		}
+
+		for _, e := range g.edges[*u.vertex] {
+			if seen[e.destination] {
+				continue
+			}
+			seen[e.destination] = true
+			queue = append(queue, queued{e.destination, u.depth + 1})
+		}
+	}
+
+	return nil
+}
+
+// DFS walks the graph depth-first from src, calling visit for each
+// discovered vertex along with its depth from src. Returning false from
+// visit prunes that vertex's subtree from the walk.
+func (g *Graph[TValue]) DFS(src *Vertex[TValue], visit func(v *Vertex[TValue], depth int) bool) error {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	if src == nil {
+		return errors.New("src cannot be nil")
+	}
+
+	if !g.containsVertex(src) {
+		return errors.New("graph does not contain src")
+	}
+
+	g.dfs(src, 0, make(map[*Vertex[TValue]]bool), visit)
+
+	return nil
+}
+
+func (g *Graph[TValue]) dfs(u *Vertex[TValue], depth int, seen map[*Vertex[TValue]]bool, visit func(v *Vertex[TValue], depth int) bool) {
+	seen[u] = true
+
+	if !visit(u, depth) {
+		return
+	}
+
+	for _, e := range g.edges[*u] {
+		if seen[e.destination] {
+			continue
+		}
+		g.dfs(e.destination, depth+1, seen, visit)
+	}
+}
+
+// BFSTree returns a new directed graph containing only the tree edges
+// discovered by a breadth-first walk from src, in discovery order, with
+// weights and tags copied from the corresponding edges in g.
+func (g *Graph[TValue]) BFSTree(src *Vertex[TValue]) *Graph[TValue] {
+	tree := &Graph[TValue]{}
+
+	if src == nil {
+		return tree
+	}
+
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	tree.AddVertex(src)
+
+	seen := map[*Vertex[TValue]]bool{src: true}
+	queue := []*Vertex[TValue]{src}
+
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g.edges[*u] {
+			if seen[e.destination] {
+				continue
+			}
+			seen[e.destination] = true
+
+			tree.AddVertex(e.destination)
+			_ = tree.AddEdge(u, e.destination, e.weight, e.tag)
+
+			queue = append(queue, e.destination)
+		}
+	}
+
+	return tree
+}
@@ -0,0 +1,90 @@
+package graph
+
+import "testing"
+
+func buildTraversalGraph() (*Graph[string], *Vertex[string], *Vertex[string], *Vertex[string], *Vertex[string]) {
+	g := &Graph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	d := &(Vertex[string]{"D"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+	g.AddVertex(d)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(a, c, 1, nil)
+	_ = g.AddEdge(b, d, 1, nil)
+
+	return g, a, b, c, d
+}
+
+func TestGraph_BFS_VisitsInBreadthFirstOrder(t *testing.T) {
+	g, a, _, _, _ := buildTraversalGraph()
+
+	var order []string
+	err := g.BFS(a, func(v *Vertex[string], depth int) bool {
+		order = append(order, v.String())
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(order) != 4 || order[0] != "A" || order[3] != "D" {
+		t.Errorf("expected A first, D (depth 2) last, and 4 vertices visited, got %v", order)
+	}
+}
+
+func TestGraph_BFS_PruneStopsSubtree(t *testing.T) {
+	g, a, _, _, _ := buildTraversalGraph()
+
+	var order []string
+	err := g.BFS(a, func(v *Vertex[string], depth int) bool {
+		order = append(order, v.String())
+		return v.String() != "B"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range order {
+		if v == "D" {
+			t.Error("expected D to be pruned since its only parent B returned false")
+		}
+	}
+}
+
+func TestGraph_DFS_VisitsAllReachable(t *testing.T) {
+	g, a, _, _, _ := buildTraversalGraph()
+
+	seen := make(map[string]bool)
+	err := g.DFS(a, func(v *Vertex[string], depth int) bool {
+		seen[v.String()] = true
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"A", "B", "C", "D"} {
+		if !seen[name] {
+			t.Errorf("expected DFS to visit %s", name)
+		}
+	}
+}
+
+func TestGraph_BFSTree(t *testing.T) {
+	g, a, b, c, d := buildTraversalGraph()
+
+	tree := g.BFSTree(a)
+
+	if !tree.ContainsVertex(a) || !tree.ContainsVertex(b) || !tree.ContainsVertex(c) || !tree.ContainsVertex(d) {
+		t.Fatal("expected the tree to contain every reachable vertex")
+	}
+
+	if !tree.ContainsEdge(a, b, nil) || !tree.ContainsEdge(a, c, nil) || !tree.ContainsEdge(b, d, nil) {
+		t.Error("expected the tree to contain the BFS tree edges")
+	}
+}
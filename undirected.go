@@ -0,0 +1,207 @@
+package graph
+
+import (
+	"errors"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// undirectedEdge is the single canonical record for an edge in an
+// UndirectedGraph. There is no "src" or "dest": a and b are interchangeable
+// endpoints, which is what keeps ContainsEdge(a, b, tag) and
+// ContainsEdge(b, a, tag) from ever drifting apart.
+type undirectedEdge[TValue comparable] struct {
+	a, b   *Vertex[TValue]
+	weight uint
+	tag    *string
+}
+
+func (e undirectedEdge[TValue]) has(v *Vertex[TValue]) bool {
+	return e.a == v || e.b == v
+}
+
+func (e undirectedEdge[TValue]) other(v *Vertex[TValue]) *Vertex[TValue] {
+	if e.a == v {
+		return e.b
+	}
+	return e.a
+}
+
+func (e undirectedEdge[TValue]) matches(a, b *Vertex[TValue], tag *string) bool {
+	if (e.a != a || e.b != b) && (e.a != b || e.b != a) {
+		return false
+	}
+
+	if e.tag == nil && tag == nil {
+		return true
+	}
+
+	return e.tag != nil && tag != nil && *e.tag == *tag
+}
+
+// UndirectedGraph is an undirected, weighted graph. Unlike Graph, where
+// "undirected" is emulated via AddSymmetricEdge storing two independent
+// entries that can drift out of sync (different weights, partial removals),
+// UndirectedGraph stores a single canonical edge record per unordered
+// {src, dest, tag} key, so ContainsEdge(a, b, tag) == ContainsEdge(b, a, tag)
+// is a structural invariant rather than something callers have to maintain.
+type UndirectedGraph[TValue comparable] struct {
+	vertices []*Vertex[TValue]
+	edges    []undirectedEdge[TValue]
+	lock     sync.RWMutex
+}
+
+// AddVertex adds a vertex to the graph without any edges. If the vertex
+// already exists, no action is taken.
+func (g *UndirectedGraph[TValue]) AddVertex(v *Vertex[TValue]) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !slices.Contains(g.vertices, v) {
+		g.vertices = append(g.vertices, v)
+	}
+}
+
+// ContainsVertex checks if the graph contains a vertex.
+func (g *UndirectedGraph[TValue]) ContainsVertex(v *Vertex[TValue]) bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return slices.Contains(g.vertices, v)
+}
+
+// AddEdge creates an undirected edge between a and b with a non-zero weight
+// and an optional tag. Supply `nil` if there's no tag.
+func (g *UndirectedGraph[TValue]) AddEdge(a, b *Vertex[TValue], weight uint, tag *string) error {
+	if weight == 0 {
+		return errors.New("weight cannot be 0")
+	}
+
+	if a == nil || b == nil {
+		return errors.New("vertices cannot be nil")
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !slices.Contains(g.vertices, a) {
+		return errors.New("unable to locate a in graph")
+	}
+
+	if !slices.Contains(g.vertices, b) {
+		return errors.New("unable to locate b in graph")
+	}
+
+	if g.containsEdge(a, b, tag) {
+		return errors.New("this edge is already present")
+	}
+
+	g.edges = append(g.edges, undirectedEdge[TValue]{a, b, weight, tag})
+
+	return nil
+}
+
+// RemoveEdge removes the edge between a and b, regardless of which order
+// they're supplied in.
+func (g *UndirectedGraph[TValue]) RemoveEdge(a, b *Vertex[TValue], tag *string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	f := func(e undirectedEdge[TValue]) bool {
+		return e.matches(a, b, tag)
+	}
+
+	if idx := slices.IndexFunc(g.edges, f); idx >= 0 {
+		g.edges = slices.Delete(g.edges, idx, idx+1)
+	}
+}
+
+// ContainsEdge checks if the graph contains an edge between a and b.
+func (g *UndirectedGraph[TValue]) ContainsEdge(a, b *Vertex[TValue], tag *string) bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.containsEdge(a, b, tag)
+}
+
+func (g *UndirectedGraph[TValue]) containsEdge(a, b *Vertex[TValue], tag *string) bool {
+	return slices.ContainsFunc(g.edges, func(e undirectedEdge[TValue]) bool {
+		return e.matches(a, b, tag)
+	})
+}
+
+// Neighbors returns every vertex directly connected to v by an edge.
+func (g *UndirectedGraph[TValue]) Neighbors(v *Vertex[TValue]) []*Vertex[TValue] {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	neighbors := make([]*Vertex[TValue], 0)
+	for _, e := range g.edges {
+		if e.has(v) {
+			neighbors = append(neighbors, e.other(v))
+		}
+	}
+
+	return neighbors
+}
+
+// Degree returns the number of edges incident to v.
+func (g *UndirectedGraph[TValue]) Degree(v *Vertex[TValue]) int {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	degree := 0
+	for _, e := range g.edges {
+		if e.has(v) {
+			degree++
+		}
+	}
+
+	return degree
+}
+
+// Vertices returns a snapshot of every vertex in the graph. It is part of
+// the WeightedGraph interface, so AStar, UniformCost and ShortestPathTree
+// can operate on an UndirectedGraph exactly as they do on a Graph.
+func (g *UndirectedGraph[TValue]) Vertices() []*Vertex[TValue] {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	vertices := make([]*Vertex[TValue], len(g.vertices))
+	copy(vertices, g.vertices)
+
+	return vertices
+}
+
+// EdgesFrom returns a snapshot of v's incident edges, from v's perspective.
+// It is part of the WeightedGraph interface.
+func (g *UndirectedGraph[TValue]) EdgesFrom(v *Vertex[TValue]) []Edge[TValue] {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	out := make([]Edge[TValue], 0)
+	for _, e := range g.edges {
+		if e.has(v) {
+			out = append(out, Edge[TValue]{e.other(v), e.weight, e.tag})
+		}
+	}
+
+	return out
+}
+
+// Weight returns the weight of the edge between src and dest (if any),
+// regardless of which order they're supplied in. It is part of the
+// WeightedGraph interface.
+func (g *UndirectedGraph[TValue]) Weight(src, dest *Vertex[TValue], tag *string) (uint, bool) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	for _, e := range g.edges {
+		if e.matches(src, dest, tag) {
+			return e.weight, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,70 @@
+package graph
+
+import "testing"
+
+func TestUndirectedGraph_AddEdge(t *testing.T) {
+	g := UndirectedGraph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+
+	if err := g.AddEdge(a, b, 1, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUndirectedGraph_ContainsEdge_IsOrderIndependent(t *testing.T) {
+	g := UndirectedGraph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+
+	_ = g.AddEdge(a, b, 5, nil)
+
+	if !g.ContainsEdge(a, b, nil) {
+		t.Error("expected ContainsEdge(a, b) to be true")
+	}
+
+	if !g.ContainsEdge(b, a, nil) {
+		t.Error("expected ContainsEdge(b, a) to be true")
+	}
+}
+
+func TestUndirectedGraph_RemoveEdge(t *testing.T) {
+	g := UndirectedGraph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+
+	_ = g.AddEdge(a, b, 5, nil)
+	g.RemoveEdge(b, a, nil)
+
+	if g.ContainsEdge(a, b, nil) {
+		t.Error("expected edge to be removed regardless of argument order")
+	}
+}
+
+func TestUndirectedGraph_NeighborsAndDegree(t *testing.T) {
+	g := UndirectedGraph[string]{}
+	a := &(Vertex[string]{"A"})
+	b := &(Vertex[string]{"B"})
+	c := &(Vertex[string]{"C"})
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	_ = g.AddEdge(a, b, 1, nil)
+	_ = g.AddEdge(a, c, 1, nil)
+
+	if degree := g.Degree(a); degree != 2 {
+		t.Errorf("expected degree of A to be 2, got %d", degree)
+	}
+
+	neighbors := g.Neighbors(a)
+	if len(neighbors) != 2 {
+		t.Errorf("expected A to have 2 neighbors, got %d", len(neighbors))
+	}
+}